@@ -0,0 +1,97 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// AirPollutionComponents contains the concentration (in ug/m3) of each pollutant
+type AirPollutionComponents struct {
+	CO   float64 `json:"co"`
+	NO   float64 `json:"no"`
+	NO2  float64 `json:"no2"`
+	O3   float64 `json:"o3"`
+	SO2  float64 `json:"so2"`
+	PM25 float64 `json:"pm2_5"`
+	PM10 float64 `json:"pm10"`
+	NH3  float64 `json:"nh3"`
+}
+
+// AirPollutionMain contains the Air Quality Index for an air pollution entry
+type AirPollutionMain struct {
+	AQI int `json:"aqi"`
+}
+
+// AirPollutionResponse contains the response from the air pollution endpoints
+type AirPollutionResponse struct {
+	Coord `json:"coord"`
+	List  []struct {
+		Dt         int64                  `json:"dt"`
+		Main       AirPollutionMain       `json:"main"`
+		Components AirPollutionComponents `json:"components"`
+	} `json:"list"`
+}
+
+// AirPollution returns the current air pollution data for a geographical coordinate.
+// Units and Lang aren't threaded through here: OWM's air pollution endpoints
+// always report AQI and component concentrations in fixed units (ug/m3) and
+// carry no localizable text, so there's nothing for those settings to affect.
+func (owm *OpenWeatherMap) AirPollution(lat, lon float64) (*AirPollutionResponse, error) {
+	return owm.AirPollutionContext(context.Background(), lat, lon)
+}
+
+// AirPollutionContext is the context-aware variant of AirPollution.
+func (owm *OpenWeatherMap) AirPollutionContext(ctx context.Context, lat, lon float64) (*AirPollutionResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+
+	url := fmt.Sprintf("http://%s/air_pollution?lat=%f&lon=%f&APPID=%s", APIURL, lat, lon, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, 0)
+	if err != nil {
+		return nil, err
+	}
+	var apr AirPollutionResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &apr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+
+	return &apr, nil
+}
+
+// AirPollutionForecast returns the forecast air pollution data for a
+// geographical coordinate. See AirPollution for why Units/Lang aren't threaded through.
+func (owm *OpenWeatherMap) AirPollutionForecast(lat, lon float64) (*AirPollutionResponse, error) {
+	return owm.AirPollutionForecastContext(context.Background(), lat, lon)
+}
+
+// AirPollutionForecastContext is the context-aware variant of AirPollutionForecast.
+func (owm *OpenWeatherMap) AirPollutionForecastContext(ctx context.Context, lat, lon float64) (*AirPollutionResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+
+	url := fmt.Sprintf("http://%s/air_pollution/forecast?lat=%f&lon=%f&APPID=%s", APIURL, lat, lon, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, 0)
+	if err != nil {
+		return nil, err
+	}
+	var apr AirPollutionResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &apr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+
+	return &apr, nil
+}
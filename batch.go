@@ -0,0 +1,162 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// cityIDGroupSize is the maximum number of city IDs the /group endpoint
+// accepts in a single request.
+const cityIDGroupSize = 20
+
+// defaultMaxConcurrency is used when OpenWeatherMap.MaxConcurrency is unset.
+const defaultMaxConcurrency = 4
+
+// groupResponse mirrors the payload returned by the /group endpoint
+type groupResponse struct {
+	List []CurrentWeatherResponse `json:"list"`
+}
+
+// MultiError collects the errors produced by a batch of independent
+// requests, so that failures in one chunk don't hide the results of
+// the others.
+type MultiError struct {
+	Errors []error
+}
+
+func (m *MultiError) Error() string {
+	msgs := make([]string, len(m.Errors))
+	for i, err := range m.Errors {
+		msgs[i] = err.Error()
+	}
+	return fmt.Sprintf("%d of the batched requests failed: %s", len(m.Errors), strings.Join(msgs, "; "))
+}
+
+// CurrentWeatherFromCityIDs returns the current weather for any number of
+// city IDs, transparently chunking the request into groups of 20 (the
+// limit enforced by the /group endpoint) and issuing the chunks
+// concurrently, capped by OpenWeatherMap.MaxConcurrency (default 4).
+// Duplicate IDs are only requested once, and the returned slice preserves
+// the ordering of ids. If some chunks fail, the responses that did
+// succeed are still returned alongside a *MultiError describing the
+// failures.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityIDs(ids []int) ([]CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCityIDsContext(context.Background(), ids)
+}
+
+// CurrentWeatherFromCityIDsContext is the context-aware variant of CurrentWeatherFromCityIDs.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityIDsContext(ctx context.Context, ids []int) ([]CurrentWeatherResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+
+	seen := make(map[int]bool, len(ids))
+	unique := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+
+	var chunks [][]int
+	for i := 0; i < len(unique); i += cityIDGroupSize {
+		end := i + cityIDGroupSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+		chunks = append(chunks, unique[i:end])
+	}
+
+	maxConcurrency := owm.MaxConcurrency
+	if maxConcurrency <= 0 {
+		maxConcurrency = defaultMaxConcurrency
+	}
+
+	results := make([]map[int]CurrentWeatherResponse, len(chunks))
+	errs := make([]error, len(chunks))
+
+	sem := make(chan struct{}, maxConcurrency)
+	var wg sync.WaitGroup
+	for i, chunk := range chunks {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, chunk []int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			byID, err := owm.currentWeatherFromCityIDChunk(ctx, chunk)
+			if err != nil {
+				errs[i] = fmt.Errorf("chunk %d: %w", i, err)
+				return
+			}
+			results[i] = byID
+		}(i, chunk)
+	}
+	wg.Wait()
+
+	combined := make(map[int]CurrentWeatherResponse)
+	for _, byID := range results {
+		for id, cwr := range byID {
+			combined[id] = cwr
+		}
+	}
+
+	ordered := make([]CurrentWeatherResponse, 0, len(unique))
+	for _, id := range unique {
+		if cwr, ok := combined[id]; ok {
+			ordered = append(ordered, cwr)
+		}
+	}
+
+	multi := &MultiError{}
+	for _, err := range errs {
+		if err != nil {
+			multi.Errors = append(multi.Errors, err)
+		}
+	}
+	if len(multi.Errors) > 0 {
+		return ordered, multi
+	}
+
+	return ordered, nil
+}
+
+func (owm *OpenWeatherMap) currentWeatherFromCityIDChunk(ctx context.Context, ids []int) (map[int]CurrentWeatherResponse, error) {
+	strIDs := make([]string, len(ids))
+	for i, id := range ids {
+		strIDs[i] = strconv.Itoa(id)
+	}
+
+	var addToQuery = ""
+	if owm.Units != "" {
+		addToQuery = "&units=" + owm.Units
+	}
+
+	url := fmt.Sprintf("http://%s/group?id=%s%s&APPID=%s", APIURL, strings.Join(strIDs, ","), addToQuery, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, owm.weatherCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+
+	var gr groupResponse
+	if jsonErr := json.Unmarshal(body, &gr); jsonErr != nil {
+		return nil, jsonErr
+	}
+
+	byID := make(map[int]CurrentWeatherResponse, len(gr.List))
+	for _, cwr := range gr.List {
+		cwr.Main.Units = owm.Units
+		cwr.Wind.Units = owm.Units
+		byID[cwr.ID] = cwr
+	}
+	return byID, nil
+}
@@ -0,0 +1,115 @@
+package openweathermap
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// stubGroupClient stands in for the network when testing
+// CurrentWeatherFromCityIDs's chunking/dedup/ordering logic. It answers
+// /group requests by echoing back the requested ids, except for any id in
+// failIDs, which fails the whole chunk with a 404.
+type stubGroupClient struct {
+	failIDs map[int]bool
+	calls   int
+}
+
+func (s *stubGroupClient) Do(req *http.Request) (*http.Response, error) {
+	s.calls++
+	idStrs := strings.Split(req.URL.Query().Get("id"), ",")
+
+	for _, idStr := range idStrs {
+		id, _ := strconv.Atoi(idStr)
+		if s.failIDs[id] {
+			body := `{"cod":"404","message":"city not found"}`
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`{"list":[`)
+	for i, idStr := range idStrs {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, `{"id":%s,"name":"city-%s"}`, idStr, idStr)
+	}
+	sb.WriteString(`]}`)
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       ioutil.NopCloser(strings.NewReader(sb.String())),
+	}, nil
+}
+
+func TestCurrentWeatherFromCityIDsDedupesAndPreservesOrder(t *testing.T) {
+	owm := &OpenWeatherMap{APIKey: "test", HTTPClient: &stubGroupClient{}}
+
+	got, err := owm.CurrentWeatherFromCityIDs([]int{3, 1, 2, 1, 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var gotIDs []int
+	for _, cwr := range got {
+		gotIDs = append(gotIDs, cwr.ID)
+	}
+
+	want := []int{3, 1, 2}
+	if len(gotIDs) != len(want) {
+		t.Fatalf("got %d results, want %d: %v", len(gotIDs), len(want), gotIDs)
+	}
+	for i := range want {
+		if gotIDs[i] != want[i] {
+			t.Fatalf("result[%d] = %d, want %d (order not preserved): %v", i, gotIDs[i], want[i], gotIDs)
+		}
+	}
+}
+
+func TestCurrentWeatherFromCityIDsUsesWeatherCache(t *testing.T) {
+	client := &stubGroupClient{}
+	owm := &OpenWeatherMap{APIKey: "test", HTTPClient: client, Cache: NewMemoryCache(10)}
+
+	if _, err := owm.CurrentWeatherFromCityIDs([]int{1, 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := owm.CurrentWeatherFromCityIDs([]int{1, 2}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if client.calls != 1 {
+		t.Fatalf("expected the second call to be served from cache (1 HTTP call total), got %d", client.calls)
+	}
+}
+
+func TestCurrentWeatherFromCityIDsPartialFailureReturnsMultiError(t *testing.T) {
+	ids := make([]int, 25) // 25 unique ids -> chunks of 20 and 5
+	for i := range ids {
+		ids[i] = i + 1
+	}
+
+	owm := &OpenWeatherMap{APIKey: "test", HTTPClient: &stubGroupClient{failIDs: map[int]bool{21: true}}}
+
+	got, err := owm.CurrentWeatherFromCityIDs(ids)
+	if err == nil {
+		t.Fatal("expected a partial failure error")
+	}
+	multi, ok := err.(*MultiError)
+	if !ok {
+		t.Fatalf("expected *MultiError, got %T: %v", err, err)
+	}
+	if len(multi.Errors) != 1 {
+		t.Fatalf("expected 1 chunk error, got %d: %v", len(multi.Errors), multi.Errors)
+	}
+
+	if len(got) != 20 {
+		t.Fatalf("expected 20 successful results from the surviving chunk, got %d", len(got))
+	}
+}
@@ -0,0 +1,190 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Cache is implemented by anything that can short-circuit an OpenWeatherMap
+// API call with a previously stored response body, keyed by the request's
+// full URL (endpoint + query), with credentials stripped.
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, data []byte, ttl time.Duration)
+}
+
+// defaultCacheTTL matches OpenWeatherMap's own refresh cadence for
+// /weather and /forecast, so callers polling faster than that don't burn
+// quota on data that hasn't changed. Override it per endpoint family with
+// OpenWeatherMap.WeatherCacheTTL / ForecastCacheTTL.
+const defaultCacheTTL = 10 * time.Minute
+
+// weatherCacheTTL returns the TTL to use for /weather (and /group) cache
+// entries, falling back to defaultCacheTTL when WeatherCacheTTL is unset.
+func (owm *OpenWeatherMap) weatherCacheTTL() time.Duration {
+	if owm.WeatherCacheTTL > 0 {
+		return owm.WeatherCacheTTL
+	}
+	return defaultCacheTTL
+}
+
+// forecastCacheTTL returns the TTL to use for /forecast cache entries,
+// falling back to defaultCacheTTL when ForecastCacheTTL is unset.
+func (owm *OpenWeatherMap) forecastCacheTTL() time.Duration {
+	if owm.ForecastCacheTTL > 0 {
+		return owm.ForecastCacheTTL
+	}
+	return defaultCacheTTL
+}
+
+type memoryCacheEntry struct {
+	data      []byte
+	expiresAt time.Time
+}
+
+// MemoryCache is an in-memory, LRU-evicted Cache implementation.
+type MemoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    []string
+	entries  map[string]memoryCacheEntry
+}
+
+// NewMemoryCache returns a MemoryCache holding at most capacity entries.
+func NewMemoryCache(capacity int) *MemoryCache {
+	return &MemoryCache{
+		capacity: capacity,
+		entries:  make(map[string]memoryCacheEntry),
+	}
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		c.removeFromOrder(key)
+		return nil, false
+	}
+	c.touch(key)
+	return entry.data, true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, data []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, exists := c.entries[key]; !exists {
+		if c.capacity > 0 && len(c.order) >= c.capacity {
+			oldest := c.order[0]
+			c.order = c.order[1:]
+			delete(c.entries, oldest)
+		}
+		c.order = append(c.order, key)
+	} else {
+		c.touch(key)
+	}
+	c.entries[key] = memoryCacheEntry{data: data, expiresAt: time.Now().Add(ttl)}
+}
+
+// touch marks key as the most recently used entry, moving it to the back
+// of the eviction order so Set doesn't reclaim it ahead of colder keys.
+func (c *MemoryCache) touch(key string) {
+	c.removeFromOrder(key)
+	c.order = append(c.order, key)
+}
+
+// removeFromOrder drops key from the eviction order, if present.
+func (c *MemoryCache) removeFromOrder(key string) {
+	for i, k := range c.order {
+		if k == key {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			return
+		}
+	}
+}
+
+// FileCache is a Cache implementation that persists entries as JSON files
+// under a directory, one file per cache key.
+type FileCache struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewFileCache returns a FileCache rooted at dir, creating it if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+type fileCacheEntry struct {
+	Data      []byte    `json:"data"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func (c *FileCache) path(key string) string {
+	return filepath.Join(c.dir, url.QueryEscape(key)+".json")
+}
+
+// Get implements Cache.
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	raw, err := ioutil.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry fileCacheEntry
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return nil, false
+	}
+	if time.Now().After(entry.ExpiresAt) {
+		os.Remove(c.path(key))
+		return nil, false
+	}
+	return entry.Data, true
+}
+
+// Set implements Cache.
+func (c *FileCache) Set(key string, data []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := fileCacheEntry{Data: data, ExpiresAt: time.Now().Add(ttl)}
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	_ = ioutil.WriteFile(c.path(key), raw, 0o644)
+}
+
+// forceRefreshKey is the context key used by WithForceRefresh.
+type forceRefreshKey struct{}
+
+// WithForceRefresh returns a context that causes every API request made
+// with it to bypass the configured Cache and refetch from the network.
+func WithForceRefresh(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceRefreshKey{}, true)
+}
+
+func forceRefresh(ctx context.Context) bool {
+	v, _ := ctx.Value(forceRefreshKey{}).(bool)
+	return v
+}
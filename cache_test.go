@@ -0,0 +1,72 @@
+package openweathermap
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheEvictsLeastRecentlyAddedWhenNeverRead(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("a"), time.Minute)
+	c.Set("b", []byte("b"), time.Minute)
+	c.Set("c", []byte("c"), time.Minute) // evicts "a"
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatal(`expected "a" to be evicted`)
+	}
+	if body, ok := c.Get("b"); !ok || string(body) != "b" {
+		t.Fatalf(`expected "b" to survive, got %q, %v`, body, ok)
+	}
+	if body, ok := c.Get("c"); !ok || string(body) != "c" {
+		t.Fatalf(`expected "c" to survive, got %q, %v`, body, ok)
+	}
+}
+
+func TestMemoryCacheGetRefreshesRecencyForEviction(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("a"), time.Minute)
+	c.Set("b", []byte("b"), time.Minute)
+	c.Get("a")                           // "a" is now more recently used than "b"
+	c.Set("c", []byte("c"), time.Minute) // should evict "b", not "a"
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal(`expected "b" to be evicted as the least recently used entry`)
+	}
+	if body, ok := c.Get("a"); !ok || string(body) != "a" {
+		t.Fatalf(`expected "a" to survive (it was read after "b"), got %q, %v`, body, ok)
+	}
+	if body, ok := c.Get("c"); !ok || string(body) != "c" {
+		t.Fatalf(`expected "c" to survive, got %q, %v`, body, ok)
+	}
+}
+
+func TestMemoryCacheExpiresByTTL(t *testing.T) {
+	c := NewMemoryCache(10)
+	c.Set("k", []byte("v"), -time.Second) // already expired
+
+	if _, ok := c.Get("k"); ok {
+		t.Fatal("expected expired entry to be absent")
+	}
+}
+
+func TestMemoryCacheOverwriteDoesNotConsumeCapacity(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("a1"), time.Minute)
+	c.Set("b", []byte("b"), time.Minute)
+	c.Set("a", []byte("a2"), time.Minute) // overwrite, should not evict "b"
+
+	if body, ok := c.Get("a"); !ok || string(body) != "a2" {
+		t.Fatalf(`expected "a" = "a2", got %q, %v`, body, ok)
+	}
+	if _, ok := c.Get("b"); !ok {
+		t.Fatal(`expected "b" to still be present`)
+	}
+}
+
+func TestCacheKeyStripsAPIKey(t *testing.T) {
+	key := cacheKey("http://api.openweathermap.org/data/2.5/weather?q=London&APPID=secret")
+	if strings.Contains(key, "secret") {
+		t.Fatalf("cache key leaked API key: %q", key)
+	}
+}
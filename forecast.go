@@ -0,0 +1,168 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ForecastFromCity returns the 5 day / 3 hour forecast for a freeform city
+func (owm *OpenWeatherMap) ForecastFromCity(city string) (*ForecastResponse, error) {
+	return owm.ForecastFromCityContext(context.Background(), city)
+}
+
+// ForecastFromCityContext is the context-aware variant of ForecastFromCity.
+func (owm *OpenWeatherMap) ForecastFromCityContext(ctx context.Context, city string) (*ForecastResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+	var addToQuery = ""
+	if owm.Units != "" {
+		addToQuery += "&units=" + owm.Units
+	}
+	if owm.Lang != "" {
+		addToQuery += "&lang=" + owm.Lang
+	}
+
+	url := fmt.Sprintf("http://%s/forecast?q=%s%s&APPID=%s", APIURL, city, addToQuery, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, owm.forecastCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+	var fr ForecastResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &fr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	for i := range fr.List {
+		fr.List[i].Main.Units = owm.Units
+		fr.List[i].Wind.Units = owm.Units
+	}
+
+	return &fr, nil
+}
+
+// ForecastFromCoordinates returns the 5 day / 3 hour forecast for a geographical coordinate
+func (owm *OpenWeatherMap) ForecastFromCoordinates(lat, long float64) (*ForecastResponse, error) {
+	return owm.ForecastFromCoordinatesContext(context.Background(), lat, long)
+}
+
+// ForecastFromCoordinatesContext is the context-aware variant of ForecastFromCoordinates.
+func (owm *OpenWeatherMap) ForecastFromCoordinatesContext(ctx context.Context, lat, long float64) (*ForecastResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+	var addToQuery = ""
+	if owm.Units != "" {
+		addToQuery += "&units=" + owm.Units
+	}
+	if owm.Lang != "" {
+		addToQuery += "&lang=" + owm.Lang
+	}
+
+	url := fmt.Sprintf("http://%s/forecast?lat=%f&lon=%f%s&APPID=%s", APIURL, lat, long, addToQuery, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, owm.forecastCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+	var fr ForecastResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &fr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	for i := range fr.List {
+		fr.List[i].Main.Units = owm.Units
+		fr.List[i].Wind.Units = owm.Units
+	}
+
+	return &fr, nil
+}
+
+// ForecastFromZip returns the 5 day / 3 hour forecast for a zipcode
+func (owm *OpenWeatherMap) ForecastFromZip(zip int) (*ForecastResponse, error) {
+	return owm.ForecastFromZipContext(context.Background(), zip)
+}
+
+// ForecastFromZipContext is the context-aware variant of ForecastFromZip.
+func (owm *OpenWeatherMap) ForecastFromZipContext(ctx context.Context, zip int) (*ForecastResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+	var addToQuery = ""
+	if owm.Units != "" {
+		addToQuery += "&units=" + owm.Units
+	}
+	if owm.Lang != "" {
+		addToQuery += "&lang=" + owm.Lang
+	}
+
+	url := fmt.Sprintf("http://%s/forecast?zip=%d%s&APPID=%s", APIURL, zip, addToQuery, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, owm.forecastCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+	var fr ForecastResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &fr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	for i := range fr.List {
+		fr.List[i].Main.Units = owm.Units
+		fr.List[i].Wind.Units = owm.Units
+	}
+
+	return &fr, nil
+}
+
+// ForecastFromCityID returns the 5 day / 3 hour forecast for a city id
+func (owm *OpenWeatherMap) ForecastFromCityID(id int) (*ForecastResponse, error) {
+	return owm.ForecastFromCityIDContext(context.Background(), id)
+}
+
+// ForecastFromCityIDContext is the context-aware variant of ForecastFromCityID.
+func (owm *OpenWeatherMap) ForecastFromCityIDContext(ctx context.Context, id int) (*ForecastResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+	var addToQuery = ""
+	if owm.Units != "" {
+		addToQuery += "&units=" + owm.Units
+	}
+	if owm.Lang != "" {
+		addToQuery += "&lang=" + owm.Lang
+	}
+
+	url := fmt.Sprintf("http://%s/forecast?id=%d%s&APPID=%s", APIURL, id, addToQuery, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, owm.forecastCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+	var fr ForecastResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &fr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	for i := range fr.List {
+		fr.List[i].Main.Units = owm.Units
+		fr.List[i].Wind.Units = owm.Units
+	}
+
+	return &fr, nil
+}
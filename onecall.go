@@ -0,0 +1,166 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// OneCallAPIURL is the URL for the One Call API
+	OneCallAPIURL string = "api.openweathermap.org/data/3.0"
+)
+
+// DailyTemp contains the temperature breakdown for a single day forecast
+type DailyTemp struct {
+	Morn  float64 `json:"morn"`
+	Day   float64 `json:"day"`
+	Eve   float64 `json:"eve"`
+	Night float64 `json:"night"`
+	Min   float64 `json:"min"`
+	Max   float64 `json:"max"`
+}
+
+// DailyFeelsLike contains the "feels like" breakdown for a single day forecast
+type DailyFeelsLike struct {
+	Morn  float64 `json:"morn"`
+	Day   float64 `json:"day"`
+	Eve   float64 `json:"eve"`
+	Night float64 `json:"night"`
+}
+
+// CurrentForecast contains the "current" block of a One Call response
+type CurrentForecast struct {
+	Dt         int64     `json:"dt"`
+	Sunrise    int64     `json:"sunrise"`
+	Sunset     int64     `json:"sunset"`
+	Temp       float64   `json:"temp"`
+	FeelsLike  float64   `json:"feels_like"`
+	Pressure   int       `json:"pressure"`
+	Humidity   int       `json:"humidity"`
+	DewPoint   float64   `json:"dew_point"`
+	UVI        float64   `json:"uvi"`
+	Clouds     int       `json:"clouds"`
+	Visibility int       `json:"visibility"`
+	WindSpeed  float64   `json:"wind_speed"`
+	WindGust   float64   `json:"wind_gust"`
+	WindDeg    float64   `json:"wind_deg"`
+	Weather    []Weather `json:"weather"`
+}
+
+// MinutelyForecast contains a single minute-by-minute precipitation entry
+type MinutelyForecast struct {
+	Dt            int64   `json:"dt"`
+	Precipitation float64 `json:"precipitation"`
+}
+
+// HourlyForecast contains a single hour's forecast entry
+type HourlyForecast struct {
+	Dt         int64     `json:"dt"`
+	Temp       float64   `json:"temp"`
+	FeelsLike  float64   `json:"feels_like"`
+	Pressure   int       `json:"pressure"`
+	Humidity   int       `json:"humidity"`
+	DewPoint   float64   `json:"dew_point"`
+	UVI        float64   `json:"uvi"`
+	Clouds     int       `json:"clouds"`
+	Visibility int       `json:"visibility"`
+	WindSpeed  float64   `json:"wind_speed"`
+	WindGust   float64   `json:"wind_gust"`
+	WindDeg    float64   `json:"wind_deg"`
+	Pop        float64   `json:"pop"`
+	Weather    []Weather `json:"weather"`
+}
+
+// DailyForecast contains a single day's forecast entry
+type DailyForecast struct {
+	Dt        int64          `json:"dt"`
+	Sunrise   int64          `json:"sunrise"`
+	Sunset    int64          `json:"sunset"`
+	Moonrise  int64          `json:"moonrise"`
+	Moonset   int64          `json:"moonset"`
+	MoonPhase float64        `json:"moon_phase"`
+	Summary   string         `json:"summary"`
+	Temp      DailyTemp      `json:"temp"`
+	FeelsLike DailyFeelsLike `json:"feels_like"`
+	Pressure  int            `json:"pressure"`
+	Humidity  int            `json:"humidity"`
+	DewPoint  float64        `json:"dew_point"`
+	WindSpeed float64        `json:"wind_speed"`
+	WindGust  float64        `json:"wind_gust"`
+	WindDeg   float64        `json:"wind_deg"`
+	Clouds    int            `json:"clouds"`
+	UVI       float64        `json:"uvi"`
+	Pop       float64        `json:"pop"`
+	Rain      float64        `json:"rain"`
+	Snow      float64        `json:"snow"`
+	Weather   []Weather      `json:"weather"`
+}
+
+// Alert contains a single weather alert issued by a national weather service
+type Alert struct {
+	SenderName  string   `json:"sender_name"`
+	Event       string   `json:"event"`
+	Start       int64    `json:"start"`
+	End         int64    `json:"end"`
+	Description string   `json:"description"`
+	Tags        []string `json:"tags"`
+}
+
+// OneCallResponse contains the response from the One Call API
+type OneCallResponse struct {
+	Lat            float64            `json:"lat"`
+	Lon            float64            `json:"lon"`
+	Timezone       string             `json:"timezone"`
+	TimezoneOffset int                `json:"timezone_offset"`
+	Current        CurrentForecast    `json:"current"`
+	Minutely       []MinutelyForecast `json:"minutely"`
+	Hourly         []HourlyForecast   `json:"hourly"`
+	Daily          []DailyForecast    `json:"daily"`
+	Alerts         []Alert            `json:"alerts"`
+}
+
+// OneCall returns the current weather, minutely/hourly/daily forecasts, UV
+// index, and any active weather alerts for a geographical coordinate. Pass
+// exclude to drop parts of the response ("current", "minutely", "hourly",
+// "daily", "alerts") that the caller doesn't need.
+func (owm *OpenWeatherMap) OneCall(lat, lon float64, exclude ...string) (*OneCallResponse, error) {
+	return owm.OneCallContext(context.Background(), lat, lon, exclude...)
+}
+
+// OneCallContext is the context-aware variant of OneCall.
+func (owm *OpenWeatherMap) OneCallContext(ctx context.Context, lat, lon float64, exclude ...string) (*OneCallResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+	var addToQuery = ""
+	if owm.Units != "" {
+		addToQuery += "&units=" + owm.Units
+	}
+	if owm.Lang != "" {
+		addToQuery += "&lang=" + owm.Lang
+	}
+	if len(exclude) > 0 {
+		addToQuery += "&exclude=" + strings.Join(exclude, ",")
+	}
+
+	url := fmt.Sprintf("http://%s/onecall?lat=%f&lon=%f%s&APPID=%s", OneCallAPIURL, lat, lon, addToQuery, owm.APIKey)
+
+	body, err := owm.makeAPIRequest(ctx, url, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var ocr OneCallResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &ocr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+
+	return &ocr, nil
+}
@@ -1,11 +1,10 @@
 package openweathermap
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"time"
 )
 
@@ -13,6 +12,31 @@ import (
 type OpenWeatherMap struct {
 	APIKey string
 	Units  string
+	// Lang selects the language OpenWeatherMap responds in, e.g. "en", "fr", "pt_br".
+	// It is sent by the Forecast*, OneCall and *WithOptions methods; the plain
+	// CurrentWeatherFrom* methods and CurrentWeatherFromCityIDs don't send it.
+	Lang string
+	// MaxConcurrency caps how many chunked requests CurrentWeatherFromCityIDs
+	// issues at once. Defaults to 4 when unset.
+	MaxConcurrency int
+	// HTTPClient overrides the client used to issue requests, so tests can
+	// stub out network access. Defaults to an *http.Client with a 60s timeout.
+	HTTPClient HTTPClient
+	// RateLimiter, if set, is consulted before every request so long-running
+	// callers can stay under OpenWeatherMap's per-minute quota.
+	RateLimiter *RateLimiter
+	// Cache, if set, short-circuits requests with a cached response body
+	// instead of hitting the network, respecting OpenWeatherMap's own
+	// refresh cadence. Only /weather, /group and /forecast responses are
+	// cached; OneCall and air pollution requests always go to the network.
+	// See WithForceRefresh to bypass it for one request.
+	Cache Cache
+	// WeatherCacheTTL overrides how long /weather (and /group) responses
+	// stay cached. Defaults to 10 minutes when unset.
+	WeatherCacheTTL time.Duration
+	// ForecastCacheTTL overrides how long /forecast responses stay cached.
+	// Defaults to 10 minutes when unset.
+	ForecastCacheTTL time.Duration
 }
 
 // City contains the city id and name info
@@ -39,6 +63,11 @@ type Weather struct {
 type Wind struct {
 	Speed float64 `json:"speed"`
 	Deg   float64 `json:"deg"`
+	// Units is the unit system the enclosing response was fetched with
+	// (e.g. "metric", "imperial", or "" for the default Kelvin/m-s units).
+	// It's stamped on the response at decode time so SpeedMPS/SpeedMPH/SpeedKPH
+	// can convert correctly regardless of the request's Units.
+	Units string `json:"-"`
 }
 
 // Clouds contains the cloud info
@@ -59,6 +88,12 @@ type Main struct {
 	Humidity  int     `json:"humidity"`
 	TempMin   float64 `json:"temp_min"`
 	TempMax   float64 `json:"temp_max"`
+	// Units is the unit system the enclosing response was fetched with
+	// (e.g. "metric", "imperial", or "" for the default Kelvin units). It's
+	// stamped on the response at decode time so TempC/TempF/TempK and their
+	// FeelsLike counterparts can convert correctly regardless of the
+	// request's Units.
+	Units string `json:"-"`
 }
 
 // Sys contains other system or ephemerides data
@@ -110,31 +145,13 @@ const (
 	APIURL string = "api.openweathermap.org/data/2.5"
 )
 
-func makeAPIRequest(url string) ([]byte, error) {
-	// Build an http client so we can have control over timeout
-	client := &http.Client{
-		Timeout: time.Second * 60,
-	}
-
-	res, getErr := client.Get(url)
-	if getErr != nil {
-		return nil, getErr
-	}
-
-	// defer the closing of the res body
-	defer res.Body.Close()
-
-	// read the http response body into a byte stream
-	body, readErr := ioutil.ReadAll(res.Body)
-	if readErr != nil {
-		return nil, readErr
-	}
-
-	return body, nil
-}
-
 // CurrentWeatherFromCity returns the current weather in a freeform city
 func (owm *OpenWeatherMap) CurrentWeatherFromCity(city string) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCityContext(context.Background(), city)
+}
+
+// CurrentWeatherFromCityContext is the context-aware variant of CurrentWeatherFromCity.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityContext(ctx context.Context, city string) (*CurrentWeatherResponse, error) {
 	if owm.APIKey == "" {
 		// No API keys present, return error
 		return nil, errors.New("No API keys present")
@@ -146,7 +163,7 @@ func (owm *OpenWeatherMap) CurrentWeatherFromCity(city string) (*CurrentWeatherR
 
 	url := fmt.Sprintf("http://%s/weather?q=%s%s&APPID=%s", APIURL, city, addToQuery, owm.APIKey)
 
-	body, err := makeAPIRequest(url)
+	body, err := owm.makeAPIRequest(ctx, url, owm.weatherCacheTTL())
 	if err != nil {
 		return nil, err
 	}
@@ -157,12 +174,19 @@ func (owm *OpenWeatherMap) CurrentWeatherFromCity(city string) (*CurrentWeatherR
 	if jsonErr != nil {
 		return nil, jsonErr
 	}
+	cwr.Main.Units = owm.Units
+	cwr.Wind.Units = owm.Units
 
 	return &cwr, nil
 }
 
 // CurrentWeatherFromCoordinates returns the current weather in geographical coordinates
 func (owm *OpenWeatherMap) CurrentWeatherFromCoordinates(lat, long float64) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCoordinatesContext(context.Background(), lat, long)
+}
+
+// CurrentWeatherFromCoordinatesContext is the context-aware variant of CurrentWeatherFromCoordinates.
+func (owm *OpenWeatherMap) CurrentWeatherFromCoordinatesContext(ctx context.Context, lat, long float64) (*CurrentWeatherResponse, error) {
 	if owm.APIKey == "" {
 		// No API keys present, return error
 		return nil, errors.New("No API keys present")
@@ -174,7 +198,7 @@ func (owm *OpenWeatherMap) CurrentWeatherFromCoordinates(lat, long float64) (*Cu
 
 	url := fmt.Sprintf("http://%s/weather?lat=%f&lon=%f%s&APPID=%s", APIURL, lat, long, addToQuery, owm.APIKey)
 
-	body, err := makeAPIRequest(url)
+	body, err := owm.makeAPIRequest(ctx, url, owm.weatherCacheTTL())
 	if err != nil {
 		return nil, err
 	}
@@ -186,12 +210,19 @@ func (owm *OpenWeatherMap) CurrentWeatherFromCoordinates(lat, long float64) (*Cu
 	if jsonErr != nil {
 		return nil, jsonErr
 	}
+	cwr.Main.Units = owm.Units
+	cwr.Wind.Units = owm.Units
 
 	return &cwr, nil
 }
 
 // CurrentWeatherFromZip returns the current weather in a zipcode
 func (owm *OpenWeatherMap) CurrentWeatherFromZip(zip int) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromZipContext(context.Background(), zip)
+}
+
+// CurrentWeatherFromZipContext is the context-aware variant of CurrentWeatherFromZip.
+func (owm *OpenWeatherMap) CurrentWeatherFromZipContext(ctx context.Context, zip int) (*CurrentWeatherResponse, error) {
 	if owm.APIKey == "" {
 		// No API keys present, return error
 		return nil, errors.New("No API keys present")
@@ -203,7 +234,7 @@ func (owm *OpenWeatherMap) CurrentWeatherFromZip(zip int) (*CurrentWeatherRespon
 
 	url := fmt.Sprintf("http://%s/weather?zip=%d%s&APPID=%s", APIURL, zip, addToQuery, owm.APIKey)
 
-	body, err := makeAPIRequest(url)
+	body, err := owm.makeAPIRequest(ctx, url, owm.weatherCacheTTL())
 	if err != nil {
 		return nil, err
 	}
@@ -214,12 +245,19 @@ func (owm *OpenWeatherMap) CurrentWeatherFromZip(zip int) (*CurrentWeatherRespon
 	if jsonErr != nil {
 		return nil, jsonErr
 	}
+	cwr.Main.Units = owm.Units
+	cwr.Wind.Units = owm.Units
 
 	return &cwr, nil
 }
 
 // CurrentWeatherFromCityID returns the current weather in a city id
 func (owm *OpenWeatherMap) CurrentWeatherFromCityID(id int) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCityIDContext(context.Background(), id)
+}
+
+// CurrentWeatherFromCityIDContext is the context-aware variant of CurrentWeatherFromCityID.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityIDContext(ctx context.Context, id int) (*CurrentWeatherResponse, error) {
 	if owm.APIKey == "" {
 		// No API keys present, return error
 		return nil, errors.New("No API keys present")
@@ -231,7 +269,7 @@ func (owm *OpenWeatherMap) CurrentWeatherFromCityID(id int) (*CurrentWeatherResp
 
 	url := fmt.Sprintf("http://%s/weather?id=%d%s&APPID=%s", APIURL, id, addToQuery, owm.APIKey)
 
-	body, err := makeAPIRequest(url)
+	body, err := owm.makeAPIRequest(ctx, url, owm.weatherCacheTTL())
 	if err != nil {
 		return nil, err
 	}
@@ -242,6 +280,8 @@ func (owm *OpenWeatherMap) CurrentWeatherFromCityID(id int) (*CurrentWeatherResp
 	if jsonErr != nil {
 		return nil, jsonErr
 	}
+	cwr.Main.Units = owm.Units
+	cwr.Wind.Units = owm.Units
 
 	return &cwr, nil
 }
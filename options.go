@@ -0,0 +1,299 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Option mutates a set of query parameters used to build an OpenWeatherMap
+// API request. Options compose, so callers can assemble exactly the query
+// they need instead of hand-building query strings.
+type Option func(url.Values)
+
+// WithUnits sets the units system ("standard", "metric", or "imperial") for the request.
+func WithUnits(units string) Option {
+	return func(v url.Values) {
+		v.Set("units", units)
+	}
+}
+
+// WithLang sets the response language, e.g. "en", "fr", "pt_br".
+func WithLang(lang string) Option {
+	return func(v url.Values) {
+		v.Set("lang", lang)
+	}
+}
+
+// WithCountryCode appends an ISO 3166 country code to a freeform city query, e.g. "London,GB".
+func WithCountryCode(code string) Option {
+	return func(v url.Values) {
+		if q := v.Get("q"); q != "" {
+			v.Set("q", q+","+code)
+		}
+	}
+}
+
+// WithZip sets a country-qualified zip/postal code query, e.g. "94040,us".
+func WithZip(zip, country string) Option {
+	return func(v url.Values) {
+		v.Set("zip", zip+","+country)
+	}
+}
+
+// WithMode sets the response encoding ("json", "xml", or "html").
+func WithMode(mode string) Option {
+	return func(v url.Values) {
+		v.Set("mode", mode)
+	}
+}
+
+// WithExclude drops parts of a OneCall response ("current", "minutely",
+// "hourly", "daily", "alerts") that the caller doesn't need.
+func WithExclude(parts ...string) Option {
+	return func(v url.Values) {
+		v.Set("exclude", strings.Join(parts, ","))
+	}
+}
+
+// BuildQuery applies opts over a base set of query values, leaving base
+// untouched. It's exported so callers can assemble a query for an endpoint
+// that doesn't yet have a dedicated WithOptions entry point below.
+func BuildQuery(base url.Values, opts ...Option) url.Values {
+	v := url.Values{}
+	for key, vals := range base {
+		v[key] = vals
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// coordQuery returns the base query values for a geographical coordinate.
+func coordQuery(lat, lon float64) url.Values {
+	return url.Values{
+		"lat": {strconv.FormatFloat(lat, 'f', -1, 64)},
+		"lon": {strconv.FormatFloat(lon, 'f', -1, 64)},
+	}
+}
+
+// CurrentWeatherFromCityWithOptions is like CurrentWeatherFromCity, but
+// takes a set of Options (WithUnits, WithLang, WithCountryCode, WithMode,
+// ...) instead of relying solely on the OpenWeatherMap's configured Units.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityWithOptions(city string, opts ...Option) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCityWithOptionsContext(context.Background(), city, opts...)
+}
+
+// CurrentWeatherFromCityWithOptionsContext is the context-aware variant of CurrentWeatherFromCityWithOptions.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityWithOptionsContext(ctx context.Context, city string, opts ...Option) (*CurrentWeatherResponse, error) {
+	base := url.Values{"q": {city}}
+	return owm.currentWeatherWithOptions(ctx, base, opts...)
+}
+
+// CurrentWeatherFromCoordinatesWithOptions is like CurrentWeatherFromCoordinates, but
+// takes a set of Options instead of relying solely on the OpenWeatherMap's configured Units.
+func (owm *OpenWeatherMap) CurrentWeatherFromCoordinatesWithOptions(lat, lon float64, opts ...Option) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCoordinatesWithOptionsContext(context.Background(), lat, lon, opts...)
+}
+
+// CurrentWeatherFromCoordinatesWithOptionsContext is the context-aware variant of CurrentWeatherFromCoordinatesWithOptions.
+func (owm *OpenWeatherMap) CurrentWeatherFromCoordinatesWithOptionsContext(ctx context.Context, lat, lon float64, opts ...Option) (*CurrentWeatherResponse, error) {
+	return owm.currentWeatherWithOptions(ctx, coordQuery(lat, lon), opts...)
+}
+
+// CurrentWeatherFromZipWithOptions is like CurrentWeatherFromZip, but takes
+// a country-qualified zip via WithZip semantics and a set of Options
+// instead of relying solely on the OpenWeatherMap's configured Units.
+func (owm *OpenWeatherMap) CurrentWeatherFromZipWithOptions(zip, country string, opts ...Option) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromZipWithOptionsContext(context.Background(), zip, country, opts...)
+}
+
+// CurrentWeatherFromZipWithOptionsContext is the context-aware variant of CurrentWeatherFromZipWithOptions.
+func (owm *OpenWeatherMap) CurrentWeatherFromZipWithOptionsContext(ctx context.Context, zip, country string, opts ...Option) (*CurrentWeatherResponse, error) {
+	base := url.Values{}
+	WithZip(zip, country)(base)
+	return owm.currentWeatherWithOptions(ctx, base, opts...)
+}
+
+// CurrentWeatherFromCityIDWithOptions is like CurrentWeatherFromCityID, but
+// takes a set of Options instead of relying solely on the OpenWeatherMap's configured Units.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityIDWithOptions(id int, opts ...Option) (*CurrentWeatherResponse, error) {
+	return owm.CurrentWeatherFromCityIDWithOptionsContext(context.Background(), id, opts...)
+}
+
+// CurrentWeatherFromCityIDWithOptionsContext is the context-aware variant of CurrentWeatherFromCityIDWithOptions.
+func (owm *OpenWeatherMap) CurrentWeatherFromCityIDWithOptionsContext(ctx context.Context, id int, opts ...Option) (*CurrentWeatherResponse, error) {
+	base := url.Values{"id": {strconv.Itoa(id)}}
+	return owm.currentWeatherWithOptions(ctx, base, opts...)
+}
+
+// currentWeatherWithOptions builds a /weather request from base (the
+// endpoint-specific location query, e.g. "q", "zip", "lat"/"lon", or "id")
+// plus opts, applying owm.Units/Lang as defaults that opts can override.
+func (owm *OpenWeatherMap) currentWeatherWithOptions(ctx context.Context, base url.Values, opts ...Option) (*CurrentWeatherResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+
+	if owm.Units != "" {
+		base.Set("units", owm.Units)
+	}
+	if owm.Lang != "" {
+		base.Set("lang", owm.Lang)
+	}
+	v := BuildQuery(base, opts...)
+	units := v.Get("units")
+	v.Set("APPID", owm.APIKey)
+
+	reqURL := fmt.Sprintf("http://%s/weather?%s", APIURL, v.Encode())
+
+	body, err := owm.makeAPIRequest(ctx, reqURL, owm.weatherCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+	var cwr CurrentWeatherResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &cwr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	cwr.Main.Units = units
+	cwr.Wind.Units = units
+
+	return &cwr, nil
+}
+
+// ForecastFromCityWithOptions is like ForecastFromCity, but takes a set of
+// Options instead of relying solely on the OpenWeatherMap's configured Units/Lang.
+func (owm *OpenWeatherMap) ForecastFromCityWithOptions(city string, opts ...Option) (*ForecastResponse, error) {
+	return owm.ForecastFromCityWithOptionsContext(context.Background(), city, opts...)
+}
+
+// ForecastFromCityWithOptionsContext is the context-aware variant of ForecastFromCityWithOptions.
+func (owm *OpenWeatherMap) ForecastFromCityWithOptionsContext(ctx context.Context, city string, opts ...Option) (*ForecastResponse, error) {
+	base := url.Values{"q": {city}}
+	return owm.forecastWithOptions(ctx, base, opts...)
+}
+
+// ForecastFromCoordinatesWithOptions is like ForecastFromCoordinates, but
+// takes a set of Options instead of relying solely on the OpenWeatherMap's configured Units/Lang.
+func (owm *OpenWeatherMap) ForecastFromCoordinatesWithOptions(lat, lon float64, opts ...Option) (*ForecastResponse, error) {
+	return owm.ForecastFromCoordinatesWithOptionsContext(context.Background(), lat, lon, opts...)
+}
+
+// ForecastFromCoordinatesWithOptionsContext is the context-aware variant of ForecastFromCoordinatesWithOptions.
+func (owm *OpenWeatherMap) ForecastFromCoordinatesWithOptionsContext(ctx context.Context, lat, lon float64, opts ...Option) (*ForecastResponse, error) {
+	return owm.forecastWithOptions(ctx, coordQuery(lat, lon), opts...)
+}
+
+// ForecastFromZipWithOptions is like ForecastFromZip, but takes a set of
+// Options instead of relying solely on the OpenWeatherMap's configured Units/Lang.
+func (owm *OpenWeatherMap) ForecastFromZipWithOptions(zip int, opts ...Option) (*ForecastResponse, error) {
+	return owm.ForecastFromZipWithOptionsContext(context.Background(), zip, opts...)
+}
+
+// ForecastFromZipWithOptionsContext is the context-aware variant of ForecastFromZipWithOptions.
+func (owm *OpenWeatherMap) ForecastFromZipWithOptionsContext(ctx context.Context, zip int, opts ...Option) (*ForecastResponse, error) {
+	base := url.Values{"zip": {strconv.Itoa(zip)}}
+	return owm.forecastWithOptions(ctx, base, opts...)
+}
+
+// ForecastFromCityIDWithOptions is like ForecastFromCityID, but takes a set of
+// Options instead of relying solely on the OpenWeatherMap's configured Units/Lang.
+func (owm *OpenWeatherMap) ForecastFromCityIDWithOptions(id int, opts ...Option) (*ForecastResponse, error) {
+	return owm.ForecastFromCityIDWithOptionsContext(context.Background(), id, opts...)
+}
+
+// ForecastFromCityIDWithOptionsContext is the context-aware variant of ForecastFromCityIDWithOptions.
+func (owm *OpenWeatherMap) ForecastFromCityIDWithOptionsContext(ctx context.Context, id int, opts ...Option) (*ForecastResponse, error) {
+	base := url.Values{"id": {strconv.Itoa(id)}}
+	return owm.forecastWithOptions(ctx, base, opts...)
+}
+
+// forecastWithOptions builds a /forecast request from base (the
+// endpoint-specific location query) plus opts, applying owm.Units/Lang as
+// defaults that opts can override.
+func (owm *OpenWeatherMap) forecastWithOptions(ctx context.Context, base url.Values, opts ...Option) (*ForecastResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+
+	if owm.Units != "" {
+		base.Set("units", owm.Units)
+	}
+	if owm.Lang != "" {
+		base.Set("lang", owm.Lang)
+	}
+	v := BuildQuery(base, opts...)
+	units := v.Get("units")
+	v.Set("APPID", owm.APIKey)
+
+	reqURL := fmt.Sprintf("http://%s/forecast?%s", APIURL, v.Encode())
+
+	body, err := owm.makeAPIRequest(ctx, reqURL, owm.forecastCacheTTL())
+	if err != nil {
+		return nil, err
+	}
+	var fr ForecastResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &fr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+	for i := range fr.List {
+		fr.List[i].Main.Units = units
+		fr.List[i].Wind.Units = units
+	}
+
+	return &fr, nil
+}
+
+// OneCallWithOptions is like OneCall, but takes a set of Options (WithUnits,
+// WithLang, WithExclude, ...) instead of relying solely on the
+// OpenWeatherMap's configured Units/Lang and a variadic exclude list.
+func (owm *OpenWeatherMap) OneCallWithOptions(lat, lon float64, opts ...Option) (*OneCallResponse, error) {
+	return owm.OneCallWithOptionsContext(context.Background(), lat, lon, opts...)
+}
+
+// OneCallWithOptionsContext is the context-aware variant of OneCallWithOptions.
+func (owm *OpenWeatherMap) OneCallWithOptionsContext(ctx context.Context, lat, lon float64, opts ...Option) (*OneCallResponse, error) {
+	if owm.APIKey == "" {
+		// No API keys present, return error
+		return nil, errors.New("No API keys present")
+	}
+
+	base := coordQuery(lat, lon)
+	if owm.Units != "" {
+		base.Set("units", owm.Units)
+	}
+	if owm.Lang != "" {
+		base.Set("lang", owm.Lang)
+	}
+	v := BuildQuery(base, opts...)
+	v.Set("APPID", owm.APIKey)
+
+	reqURL := fmt.Sprintf("http://%s/onecall?%s", OneCallAPIURL, v.Encode())
+
+	body, err := owm.makeAPIRequest(ctx, reqURL, 0)
+	if err != nil {
+		return nil, err
+	}
+	var ocr OneCallResponse
+
+	// unmarshal the byte stream into a Go data type
+	jsonErr := json.Unmarshal(body, &ocr)
+	if jsonErr != nil {
+		return nil, jsonErr
+	}
+
+	return &ocr, nil
+}
@@ -0,0 +1,72 @@
+package openweathermap
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestBuildQueryLeavesBaseUntouched(t *testing.T) {
+	base := url.Values{"q": {"London"}}
+	v := BuildQuery(base, WithUnits("metric"))
+
+	if base.Get("units") != "" {
+		t.Fatalf("expected base to be left untouched, got units=%q", base.Get("units"))
+	}
+	if got := v.Get("units"); got != "metric" {
+		t.Fatalf("units = %q, want metric", got)
+	}
+	if got := v.Get("q"); got != "London" {
+		t.Fatalf("q = %q, want London", got)
+	}
+}
+
+func TestWithCountryCodeAppendsToQ(t *testing.T) {
+	v := BuildQuery(url.Values{"q": {"London"}}, WithCountryCode("GB"))
+	if got := v.Get("q"); got != "London,GB" {
+		t.Fatalf("q = %q, want London,GB", got)
+	}
+}
+
+func TestWithCountryCodeIsNoopWithoutQ(t *testing.T) {
+	v := BuildQuery(url.Values{}, WithCountryCode("GB"))
+	if got := v.Get("q"); got != "" {
+		t.Fatalf("q = %q, want empty (no freeform city query to qualify)", got)
+	}
+}
+
+func TestWithZipSetsCountryQualifiedZip(t *testing.T) {
+	v := BuildQuery(url.Values{}, WithZip("94040", "us"))
+	if got := v.Get("zip"); got != "94040,us" {
+		t.Fatalf("zip = %q, want 94040,us", got)
+	}
+}
+
+func TestWithExcludeJoinsParts(t *testing.T) {
+	v := BuildQuery(url.Values{}, WithExclude("minutely", "hourly"))
+	if got := v.Get("exclude"); got != "minutely,hourly" {
+		t.Fatalf("exclude = %q, want minutely,hourly", got)
+	}
+}
+
+func TestOptionsOverrideOWMDefaults(t *testing.T) {
+	owm := &OpenWeatherMap{APIKey: "test", Units: "standard"}
+	base := url.Values{"q": {"London"}}
+	if owm.Units != "" {
+		base.Set("units", owm.Units)
+	}
+	v := BuildQuery(base, WithUnits("imperial"))
+
+	if got := v.Get("units"); got != "imperial" {
+		t.Fatalf("units = %q, want the Option to override owm.Units (imperial)", got)
+	}
+}
+
+func TestCoordQueryFormatsLatLon(t *testing.T) {
+	v := coordQuery(37.386, -122.0838)
+	if got := v.Get("lat"); got != "37.386" {
+		t.Fatalf("lat = %q, want 37.386", got)
+	}
+	if got := v.Get("lon"); got != "-122.0838" {
+		t.Fatalf("lon = %q, want -122.0838", got)
+	}
+}
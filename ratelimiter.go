@@ -0,0 +1,74 @@
+package openweathermap
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RateLimiter is a simple token-bucket limiter used to keep callers under
+// OpenWeatherMap's per-minute request quota (60 req/min on the free tier).
+// It allows burst requests immediately and refills one token every interval
+// thereafter.
+type RateLimiter struct {
+	tokens   chan struct{}
+	interval time.Duration
+	stop     chan struct{}
+}
+
+// NewRateLimiter returns a RateLimiter that allows burst requests
+// immediately and grants one additional token every interval thereafter.
+// For OpenWeatherMap's free tier (60 req/min), NewRateLimiter(1, time.Second)
+// keeps a long-running collector under quota; a larger burst lets up to burst
+// extra requests through on top of the refill rate. Both burst and interval must
+// be positive; interval <= 0 returns an error rather than panicking.
+func NewRateLimiter(burst int, interval time.Duration) (*RateLimiter, error) {
+	if burst <= 0 {
+		return nil, errors.New("openweathermap: NewRateLimiter burst must be positive")
+	}
+	if interval <= 0 {
+		return nil, errors.New("openweathermap: NewRateLimiter interval must be positive")
+	}
+
+	rl := &RateLimiter{
+		tokens:   make(chan struct{}, burst),
+		interval: interval,
+		stop:     make(chan struct{}),
+	}
+	for i := 0; i < burst; i++ {
+		rl.tokens <- struct{}{}
+	}
+	go rl.refill()
+	return rl, nil
+}
+
+func (rl *RateLimiter) refill() {
+	ticker := time.NewTicker(rl.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			select {
+			case rl.tokens <- struct{}{}:
+			default:
+			}
+		case <-rl.stop:
+			return
+		}
+	}
+}
+
+// Wait blocks until a token is available or ctx is canceled.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	select {
+	case <-rl.tokens:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Stop releases the RateLimiter's background refill goroutine.
+func (rl *RateLimiter) Stop() {
+	close(rl.stop)
+}
@@ -0,0 +1,56 @@
+package openweathermap
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewRateLimiterRejectsNonPositiveArgs(t *testing.T) {
+	if _, err := NewRateLimiter(0, time.Millisecond); err == nil {
+		t.Fatal("expected an error for a non-positive burst")
+	}
+	if _, err := NewRateLimiter(1, 0); err == nil {
+		t.Fatal("expected an error for a non-positive interval")
+	}
+}
+
+func TestRateLimiterWaitConsumesBurstThenBlocksUntilRefill(t *testing.T) {
+	rl, err := NewRateLimiter(1, 20*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer rl.Stop()
+
+	ctx := context.Background()
+	if err := rl.Wait(ctx); err != nil {
+		t.Fatalf("expected the burst token to be available immediately: %v", err)
+	}
+
+	start := time.Now()
+	if err := rl.Wait(ctx); err != nil {
+		t.Fatalf("unexpected error waiting for refill: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Fatalf("expected Wait to block until the next refill, returned after %v", elapsed)
+	}
+}
+
+func TestRateLimiterWaitReturnsOnContextCancel(t *testing.T) {
+	rl, err := NewRateLimiter(1, time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer rl.Stop()
+
+	if err := rl.Wait(context.Background()); err != nil {
+		t.Fatalf("expected the burst token to be available immediately: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := rl.Wait(ctx); err != ctx.Err() {
+		t.Fatalf("expected Wait to return the context error, got %v", err)
+	}
+}
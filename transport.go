@@ -0,0 +1,151 @@
+package openweathermap
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// HTTPClient is the subset of *http.Client used to issue requests. It is
+// exposed on OpenWeatherMap so tests can stub out network access.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// APIError represents the JSON error envelope OpenWeatherMap returns on
+// non-2xx responses, e.g. {"cod":"404","message":"city not found"}.
+type APIError struct {
+	Code    int
+	Message string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("openweathermap: %d %s", e.Code, e.Message)
+}
+
+// apiErrorEnvelope mirrors OWM's error payload. cod is sometimes a JSON
+// string ("404") and sometimes a JSON number, so it's decoded loosely and
+// only used for the message.
+type apiErrorEnvelope struct {
+	Message string `json:"message"`
+}
+
+const (
+	defaultTimeout    = time.Second * 60
+	maxRetries        = 3
+	defaultRetryAfter = time.Second
+)
+
+// httpClient returns the configured HTTPClient, falling back to one with
+// the package's historical 60s timeout.
+func (owm *OpenWeatherMap) httpClient() HTTPClient {
+	if owm.HTTPClient != nil {
+		return owm.HTTPClient
+	}
+	return &http.Client{Timeout: defaultTimeout}
+}
+
+// cacheKey strips credentials (APPID) from a request URL so the cache never
+// persists an API key, whether in an in-memory map or an on-disk filename.
+func cacheKey(requestURL string) string {
+	u, err := url.Parse(requestURL)
+	if err != nil {
+		return requestURL
+	}
+	q := u.Query()
+	q.Del("APPID")
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// makeAPIRequest issues a GET request against reqURL on behalf of owm. If
+// owm.Cache is set and ttl > 0, a cached body is returned without hitting
+// the network (unless ctx carries WithForceRefresh), and a successful
+// response is stored back in the cache for ttl, keyed by the request with
+// its APPID stripped. It also waits on owm.RateLimiter before every attempt,
+// retries on 429/5xx with exponential backoff (honoring a Retry-After header
+// given in seconds; the HTTP-date form falls back to the backoff),
+// and decodes OpenWeatherMap's JSON error envelope into an *APIError when a
+// request ultimately fails.
+func (owm *OpenWeatherMap) makeAPIRequest(ctx context.Context, reqURL string, ttl time.Duration) ([]byte, error) {
+	var key string
+	if owm.Cache != nil && ttl > 0 {
+		key = cacheKey(reqURL)
+		if !forceRefresh(ctx) {
+			if body, ok := owm.Cache.Get(key); ok {
+				return body, nil
+			}
+		}
+	}
+
+	client := owm.httpClient()
+	backoff := defaultRetryAfter
+
+	var lastErr error
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if owm.RateLimiter != nil {
+			if err := owm.RateLimiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+
+		body, err := ioutil.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		if res.StatusCode >= 200 && res.StatusCode < 300 {
+			if owm.Cache != nil && ttl > 0 {
+				owm.Cache.Set(key, body, ttl)
+			}
+			return body, nil
+		}
+
+		apiErr := &APIError{Code: res.StatusCode}
+		var envelope apiErrorEnvelope
+		if jsonErr := json.Unmarshal(body, &envelope); jsonErr == nil {
+			apiErr.Message = envelope.Message
+		}
+		lastErr = apiErr
+
+		// Only 429 and 5xx are worth retrying; everything else (404, 401, ...) is final.
+		if res.StatusCode != http.StatusTooManyRequests && res.StatusCode < 500 {
+			return nil, apiErr
+		}
+		if attempt == maxRetries {
+			break
+		}
+
+		wait := backoff
+		if ra := res.Header.Get("Retry-After"); ra != "" {
+			if secs, convErr := strconv.Atoi(ra); convErr == nil {
+				wait = time.Duration(secs) * time.Second
+			}
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		backoff *= 2
+	}
+
+	return nil, lastErr
+}
@@ -0,0 +1,70 @@
+package openweathermap
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// stubSequenceClient returns its responses in order, one per call to Do.
+type stubSequenceClient struct {
+	responses []*http.Response
+	calls     int
+}
+
+func (s *stubSequenceClient) Do(req *http.Request) (*http.Response, error) {
+	res := s.responses[s.calls]
+	s.calls++
+	return res, nil
+}
+
+func newStubResponse(status int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestMakeAPIRequestRetriesOn5xxThenSucceeds(t *testing.T) {
+	client := &stubSequenceClient{responses: []*http.Response{
+		newStubResponse(http.StatusServiceUnavailable, `{"cod":"503","message":"try again"}`),
+		newStubResponse(http.StatusOK, `{"ok":true}`),
+	}}
+	owm := &OpenWeatherMap{APIKey: "test", HTTPClient: client}
+
+	body, err := owm.makeAPIRequest(context.Background(), "http://example.com/weather?APPID=test", 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(body) != `{"ok":true}` {
+		t.Fatalf("unexpected body: %q", body)
+	}
+	if client.calls != 2 {
+		t.Fatalf("expected 2 calls (1 retry), got %d", client.calls)
+	}
+}
+
+func TestMakeAPIRequestDoesNotRetryOn404(t *testing.T) {
+	client := &stubSequenceClient{responses: []*http.Response{
+		newStubResponse(http.StatusNotFound, `{"cod":"404","message":"city not found"}`),
+	}}
+	owm := &OpenWeatherMap{APIKey: "test", HTTPClient: client}
+
+	_, err := owm.makeAPIRequest(context.Background(), "http://example.com/weather?APPID=test", 0)
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	apiErr, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if apiErr.Code != http.StatusNotFound || apiErr.Message != "city not found" {
+		t.Fatalf("unexpected APIError: %+v", apiErr)
+	}
+	if client.calls != 1 {
+		t.Fatalf("expected no retries for a 404, got %d calls", client.calls)
+	}
+}
@@ -0,0 +1,99 @@
+package openweathermap
+
+// TempK returns Main.Temp normalized to Kelvin, regardless of the Units
+// the response was fetched with.
+func (m Main) TempK() float64 {
+	switch m.Units {
+	case "metric":
+		return m.Temp + 273.15
+	case "imperial":
+		return (m.Temp-32)*5/9 + 273.15
+	default:
+		return m.Temp
+	}
+}
+
+// TempC returns Main.Temp normalized to Celsius, regardless of the Units
+// the response was fetched with.
+func (m Main) TempC() float64 {
+	switch m.Units {
+	case "metric":
+		return m.Temp
+	case "imperial":
+		return (m.Temp - 32) * 5 / 9
+	default:
+		return m.Temp - 273.15
+	}
+}
+
+// TempF returns Main.Temp normalized to Fahrenheit, regardless of the Units
+// the response was fetched with.
+func (m Main) TempF() float64 {
+	switch m.Units {
+	case "metric":
+		return m.Temp*9/5 + 32
+	case "imperial":
+		return m.Temp
+	default:
+		return (m.Temp-273.15)*9/5 + 32
+	}
+}
+
+// FeelsLikeK returns Main.FeelsLike normalized to Kelvin.
+func (m Main) FeelsLikeK() float64 {
+	switch m.Units {
+	case "metric":
+		return m.FeelsLike + 273.15
+	case "imperial":
+		return (m.FeelsLike-32)*5/9 + 273.15
+	default:
+		return m.FeelsLike
+	}
+}
+
+// FeelsLikeC returns Main.FeelsLike normalized to Celsius.
+func (m Main) FeelsLikeC() float64 {
+	switch m.Units {
+	case "metric":
+		return m.FeelsLike
+	case "imperial":
+		return (m.FeelsLike - 32) * 5 / 9
+	default:
+		return m.FeelsLike - 273.15
+	}
+}
+
+// FeelsLikeF returns Main.FeelsLike normalized to Fahrenheit.
+func (m Main) FeelsLikeF() float64 {
+	switch m.Units {
+	case "metric":
+		return m.FeelsLike*9/5 + 32
+	case "imperial":
+		return m.FeelsLike
+	default:
+		return (m.FeelsLike-273.15)*9/5 + 32
+	}
+}
+
+// SpeedMPS returns Wind.Speed normalized to meters/second, regardless of
+// the Units the response was fetched with (OWM reports wind speed in m/s
+// for "standard"/"metric" and mph for "imperial").
+func (w Wind) SpeedMPS() float64 {
+	if w.Units == "imperial" {
+		return w.Speed * 0.44704
+	}
+	return w.Speed
+}
+
+// SpeedMPH returns Wind.Speed normalized to miles/hour.
+func (w Wind) SpeedMPH() float64 {
+	if w.Units == "imperial" {
+		return w.Speed
+	}
+	return w.Speed * 2.23694
+}
+
+// SpeedKPH returns Wind.Speed normalized to kilometers/hour.
+func (w Wind) SpeedKPH() float64 {
+	return w.SpeedMPS() * 3.6
+}
@@ -0,0 +1,69 @@
+package openweathermap
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6
+}
+
+func TestMainTempConversions(t *testing.T) {
+	cases := []struct {
+		name                string
+		units               string
+		temp                float64
+		wantC, wantF, wantK float64
+	}{
+		{"metric", "metric", 20, 20, 68, 293.15},
+		{"imperial", "imperial", 68, 20, 68, 293.15},
+		{"standard", "", 293.15, 20, 68, 293.15},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			m := Main{Temp: c.temp, Units: c.units}
+			if got := m.TempC(); !almostEqual(got, c.wantC) {
+				t.Errorf("TempC() = %v, want %v", got, c.wantC)
+			}
+			if got := m.TempF(); !almostEqual(got, c.wantF) {
+				t.Errorf("TempF() = %v, want %v", got, c.wantF)
+			}
+			if got := m.TempK(); !almostEqual(got, c.wantK) {
+				t.Errorf("TempK() = %v, want %v", got, c.wantK)
+			}
+		})
+	}
+}
+
+func TestMainFeelsLikeConversions(t *testing.T) {
+	m := Main{FeelsLike: 25, Units: "metric"}
+	if got := m.FeelsLikeC(); !almostEqual(got, 25) {
+		t.Errorf("FeelsLikeC() = %v, want 25", got)
+	}
+	if got := m.FeelsLikeF(); !almostEqual(got, 77) {
+		t.Errorf("FeelsLikeF() = %v, want 77", got)
+	}
+	if got := m.FeelsLikeK(); !almostEqual(got, 298.15) {
+		t.Errorf("FeelsLikeK() = %v, want 298.15", got)
+	}
+}
+
+func TestWindSpeedConversions(t *testing.T) {
+	imperial := Wind{Speed: 10, Units: "imperial"}
+	if got := imperial.SpeedMPH(); !almostEqual(got, 10) {
+		t.Errorf("SpeedMPH() = %v, want 10", got)
+	}
+	if got := imperial.SpeedMPS(); !almostEqual(got, 4.4704) {
+		t.Errorf("SpeedMPS() = %v, want 4.4704", got)
+	}
+
+	metric := Wind{Speed: 5, Units: "metric"}
+	if got := metric.SpeedMPS(); !almostEqual(got, 5) {
+		t.Errorf("SpeedMPS() = %v, want 5", got)
+	}
+	if got := metric.SpeedKPH(); !almostEqual(got, 18) {
+		t.Errorf("SpeedKPH() = %v, want 18", got)
+	}
+}